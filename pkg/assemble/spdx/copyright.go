@@ -0,0 +1,51 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"strings"
+)
+
+// unionCopyright merges the copyright texts observed across the described
+// packages of each input SBOM with the configured App.Copyright, producing
+// a deduplicated multi-line block instead of silently overwriting what the
+// inputs already asserted.
+//
+// Each text is kept as a whole block rather than split line-by-line: a
+// multi-line copyright notice (e.g. several holders under one package) is
+// one entry, deduped and ordered against the other inputs' blocks, not
+// scrambled by sorting its individual lines alongside unrelated holders.
+func unionCopyright(texts ...string) string {
+	seen := map[string]struct{}{}
+	blocks := []string{}
+
+	for _, text := range texts {
+		block := strings.TrimSpace(text)
+		if block == "" || block == "NOASSERTION" {
+			continue
+		}
+		if _, ok := seen[block]; ok {
+			continue
+		}
+		seen[block] = struct{}{}
+		blocks = append(blocks, block)
+	}
+
+	if len(blocks) == 0 {
+		return "NOASSERTION"
+	}
+
+	return strings.Join(blocks, "\n")
+}
@@ -0,0 +1,171 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/google/uuid"
+	"github.com/samber/lo"
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+// fileSHA1 returns the SHA1 checksum of f, the identity we dedup files by,
+// or "" if the file carries none.
+func fileSHA1(f *spdx.File) string {
+	for _, c := range f.Checksums {
+		if c.Algorithm == common.SHA1 {
+			return c.Value
+		}
+	}
+	return ""
+}
+
+// mergeFileMetadata folds src into the surviving dst file: license info is
+// unioned and the longer copyright statement wins, so a duplicate with
+// richer metadata never silently loses it.
+func mergeFileMetadata(dst, src *spdx.File) {
+	dst.LicenseInfoInFiles = lo.Uniq(append(dst.LicenseInfoInFiles, src.LicenseInfoInFiles...))
+	if len(src.FileCopyrightText) > len(dst.FileCopyrightText) {
+		dst.FileCopyrightText = src.FileCopyrightText
+	}
+}
+
+// dedupeFiles dedups doc's files by SHA1 checksum against the files
+// already kept in bySHA1, appending survivors to files and returning the
+// old-ID -> surviving-ID remap for this document's file references.
+// licRemap is applied to each file's license fields first, so a file never
+// ends up pointing at an OtherLicense ID that dedupeOtherLicenses renamed.
+//
+// Every survivor is assigned a fresh element ID, even when it isn't
+// SHA1-deduped: two inputs commonly reuse the same short FileSPDXIdentifier
+// (e.g. "File-1"), and leaving those untouched would emit duplicate
+// identifiers in the merged document.
+func dedupeFiles(docFiles []*spdx.File, licRemap map[string]string, files *[]*spdx.File, bySHA1 map[string]*spdx.File) map[common.ElementID]common.ElementID {
+	remap := map[common.ElementID]common.ElementID{}
+
+	for _, f := range docFiles {
+		cf := *f
+		rewriteFileLicenseRefs(&cf, licRemap)
+
+		sha1 := fileSHA1(&cf)
+		if sha1 != "" {
+			if existing, ok := bySHA1[sha1]; ok {
+				mergeFileMetadata(existing, &cf)
+				remap[f.FileSPDXIdentifier] = existing.FileSPDXIdentifier
+				continue
+			}
+		}
+
+		cf.FileSPDXIdentifier = common.ElementID(fmt.Sprintf("File-%s", uuid.New().String()))
+		remap[f.FileSPDXIdentifier] = cf.FileSPDXIdentifier
+
+		if sha1 != "" {
+			bySHA1[sha1] = &cf
+		}
+		*files = append(*files, &cf)
+	}
+
+	return remap
+}
+
+// nextLicenseRefSuffix finds the first "<id>-N" suffix not already present
+// in used, starting at N=2 (the first duplicate becomes "-2").
+func nextLicenseRefSuffix(id string, used map[string]*spdx.OtherLicense) string {
+	for n := 2; ; n++ {
+		candidate := fmt.Sprintf("%s-%d", id, n)
+		if _, ok := used[candidate]; !ok {
+			return candidate
+		}
+	}
+}
+
+// dedupeOtherLicenses dedups doc's OtherLicenses by LicenseIdentifier
+// against the licenses already kept in byID. Identical ExtractedText is
+// merged as-is; differing text is kept under a renamed LicenseRef-*-N id.
+// The returned remap maps this document's original identifiers to whatever
+// identifier they ended up under, for rewriting license expressions.
+func dedupeOtherLicenses(docLics []*spdx.OtherLicense, otherLics *[]*spdx.OtherLicense, byID map[string]*spdx.OtherLicense) map[string]string {
+	remap := map[string]string{}
+
+	for _, ol := range docLics {
+		existing, ok := byID[ol.LicenseIdentifier]
+		if !ok {
+			cl := *ol
+			byID[ol.LicenseIdentifier] = &cl
+			*otherLics = append(*otherLics, &cl)
+			continue
+		}
+
+		if existing.ExtractedText == ol.ExtractedText {
+			continue
+		}
+
+		newID := nextLicenseRefSuffix(ol.LicenseIdentifier, byID)
+		renamed := *ol
+		renamed.LicenseIdentifier = newID
+		byID[newID] = &renamed
+		*otherLics = append(*otherLics, &renamed)
+		remap[ol.LicenseIdentifier] = newID
+	}
+
+	return remap
+}
+
+// licenseRefTokenRe matches the identifier-like tokens a license expression
+// is built from (license IDs and LicenseRef-* ids), so a rename only ever
+// touches a whole token and never a substring of an unrelated one (e.g.
+// renaming "LicenseRef-foo" must not also touch "LicenseRef-foobar").
+var licenseRefTokenRe = regexp.MustCompile(`[A-Za-z0-9.\-+]+`)
+
+// rewriteLicenseRefs replaces whole-token occurrences of a renamed
+// LicenseRef-* ID in a license expression with its new identifier. Each
+// token is looked up once against the final remap, so chained renames
+// (foo->foo-2, foo-2->foo-3) can never double-apply.
+func rewriteLicenseRefs(expr string, remap map[string]string) string {
+	if len(remap) == 0 {
+		return expr
+	}
+	return licenseRefTokenRe.ReplaceAllStringFunc(expr, func(tok string) string {
+		if to, ok := remap[tok]; ok {
+			return to
+		}
+		return tok
+	})
+}
+
+// rewritePackageLicenseRefs applies remap to the license expressions a
+// package carries.
+func rewritePackageLicenseRefs(p *spdx.Package, remap map[string]string) {
+	if len(remap) == 0 {
+		return
+	}
+	p.PackageLicenseConcluded = rewriteLicenseRefs(p.PackageLicenseConcluded, remap)
+	p.PackageLicenseDeclared = rewriteLicenseRefs(p.PackageLicenseDeclared, remap)
+}
+
+// rewriteFileLicenseRefs applies remap to the license expressions a file
+// carries.
+func rewriteFileLicenseRefs(f *spdx.File, remap map[string]string) {
+	if len(remap) == 0 {
+		return
+	}
+	f.LicenseConcluded = rewriteLicenseRefs(f.LicenseConcluded, remap)
+	for i, l := range f.LicenseInfoInFiles {
+		f.LicenseInfoInFiles[i] = rewriteLicenseRefs(l, remap)
+	}
+}
@@ -0,0 +1,58 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import "strings"
+
+// Format identifies the serialization used when reading or writing an
+// SPDX document.
+type Format string
+
+const (
+	FormatJSON Format = "json"
+	FormatTV   Format = "tv"
+	FormatYAML Format = "yaml"
+	FormatRDF  Format = "rdf"
+)
+
+// SniffFormat infers the desired SPDX serialization from a file's
+// extension, defaulting to JSON when nothing matches (e.g. stdout).
+func SniffFormat(path string) Format {
+	lower := strings.ToLower(path)
+
+	switch {
+	case strings.HasSuffix(lower, ".spdx.json"), strings.HasSuffix(lower, ".json"):
+		return FormatJSON
+	case strings.HasSuffix(lower, ".spdx.yaml"), strings.HasSuffix(lower, ".spdx.yml"),
+		strings.HasSuffix(lower, ".yaml"), strings.HasSuffix(lower, ".yml"):
+		return FormatYAML
+	case strings.HasSuffix(lower, ".spdx.rdf"), strings.HasSuffix(lower, ".rdf"):
+		return FormatRDF
+	case strings.HasSuffix(lower, ".spdx"):
+		return FormatTV
+	default:
+		return FormatJSON
+	}
+}
+
+// ResolveFormat honors an explicit format override, falling back to
+// extension sniffing against path when explicit is empty or unrecognized.
+func ResolveFormat(explicit, path string) Format {
+	switch Format(strings.ToLower(explicit)) {
+	case FormatJSON, FormatTV, FormatYAML, FormatRDF:
+		return Format(strings.ToLower(explicit))
+	}
+	return SniffFormat(path)
+}
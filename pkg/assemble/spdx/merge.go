@@ -15,7 +15,6 @@
 package spdx
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"os"
@@ -223,6 +222,13 @@ func (m *merge) hierarchicalMerge() error {
 
 	pkgs := []*spdx.Package{pc}
 	deps := []*spdx.Relationship{}
+	describedCopyrights := []string{}
+
+	files := []*spdx.File{}
+	fileBySHA1 := map[string]*spdx.File{}
+	snippets := []*spdx.Snippet{}
+	otherLics := []*spdx.OtherLicense{}
+	otherLicByID := map[string]*spdx.OtherLicense{}
 
 	//Add relationship between document and primary package
 	deps = append(deps, &spdx.Relationship{
@@ -245,6 +251,12 @@ func (m *merge) hierarchicalMerge() error {
 			return rel.Relationship == common.TypeRelationshipDescribe
 		})
 
+		// Fold this doc's other-licenses and files into the running sets
+		// before touching packages/relationships, so the remaps are ready
+		// to rewrite whatever references them.
+		licRemap := dedupeOtherLicenses(doc.OtherLicenses, &otherLics, otherLicByID)
+		fileRemap := dedupeFiles(doc.Files, licRemap, &files, fileBySHA1)
+
 		for _, pkg := range doc.Packages {
 			isDescPkg := m.isDescribedPackage(pkg, descRels)
 
@@ -254,7 +266,17 @@ func (m *merge) hierarchicalMerge() error {
 				continue
 			}
 
+			rewritePackageLicenseRefs(cPkg, licRemap)
+
+			// cloneComp is a generic deep-copy and doesn't know these are
+			// part of the package's identity; carry them explicitly so
+			// attribution and the original filename survive the merge.
+			cPkg.PackageAttributionTexts = pkg.PackageAttributionTexts
+			cPkg.PackageFileName = pkg.PackageFileName
+
 			if isDescPkg {
+				describedCopyrights = append(describedCopyrights, cPkg.PackageCopyrightText)
+
 				//Change the SPDX Identifier to the package specified
 				cPkg.PackageSPDXIdentifier = common.ElementID(fmt.Sprintf("Package-%s", uuid.New().String()))
 
@@ -279,6 +301,40 @@ func (m *merge) hierarchicalMerge() error {
 			}
 			pkgs = append(pkgs, cPkg)
 		}
+
+		// Mint every snippet a fresh ID up front: two inputs can reuse the
+		// same short SnippetSPDXIdentifier (e.g. "Snippet-1"), which would
+		// otherwise produce duplicate identifiers in the merged doc.
+		snipRemap := map[common.ElementID]common.ElementID{}
+		for _, snip := range doc.Snippets {
+			cs := *snip
+			if survivor, ok := fileRemap[snip.SnippetFromFileSPDXIdentifier]; ok {
+				cs.SnippetFromFileSPDXIdentifier = survivor
+			}
+			cs.SnippetSPDXIdentifier = common.ElementID(fmt.Sprintf("Snippet-%s", uuid.New().String()))
+			snipRemap[snip.SnippetSPDXIdentifier] = cs.SnippetSPDXIdentifier
+			snippets = append(snippets, &cs)
+		}
+
+		// Point relationships at whichever file/snippet survived the dedup
+		// and renaming passes above.
+		lo.ForEach(doc.Relationships, func(rel *spdx.Relationship, _ int) {
+			if rel == nil {
+				return
+			}
+			if survivor, ok := fileRemap[rel.RefA.ElementRefID]; ok {
+				rel.RefA.ElementRefID = survivor
+			}
+			if survivor, ok := fileRemap[rel.RefB.ElementRefID]; ok {
+				rel.RefB.ElementRefID = survivor
+			}
+			if survivor, ok := snipRemap[rel.RefA.ElementRefID]; ok {
+				rel.RefA.ElementRefID = survivor
+			}
+			if survivor, ok := snipRemap[rel.RefB.ElementRefID]; ok {
+				rel.RefB.ElementRefID = survivor
+			}
+		})
 	}
 
 	deps = append(deps, lo.FlatMap(m.in, func(doc *spdx.Document, _ int) []*spdx.Relationship {
@@ -290,16 +346,14 @@ func (m *merge) hierarchicalMerge() error {
 		})
 	})...)
 
-	files := lo.Flatten(lo.Map(m.in, func(pkg *spdx.Document, _ int) []*spdx.File {
-		return pkg.Files
-	}))
-
-	otherLics := lo.FlatMap(m.in, func(doc *spdx.Document, _ int) []*spdx.OtherLicense {
-		return doc.OtherLicenses
-	})
+	// Union the primary component's configured copyright with whatever the
+	// described packages of each input already asserted, rather than
+	// dropping theirs on the floor.
+	pc.PackageCopyrightText = unionCopyright(append([]string{pc.PackageCopyrightText}, describedCopyrights...)...)
 
 	m.out.Packages = pkgs
 	m.out.Files = files
+	m.out.Snippets = snippets
 	m.out.Relationships = deps
 	m.out.OtherLicenses = otherLics
 
@@ -326,7 +380,9 @@ func (m *merge) writeSBOM() error {
 		}
 	}
 
-	buf, err := json.MarshalIndent(m.out, "", " ")
+	format := ResolveFormat(m.settings.Output.Format, outName)
+
+	buf, err := Marshal(m.out, format)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,119 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// tools-golang has no RDF/XML saver (only a loader) as of the version this
+// repo pins, so FormatRDF is produced by this hand-rolled, best-effort
+// writer rather than a library call. It covers the document, its packages,
+// its files, and its relationships (including the describes/contains edges
+// sbomasm itself generates) - enough for `sbomasm merge`/`sbomasm edit`
+// round-trips to preserve the merged hierarchy - but is not a full
+// SPDX-to-RDF mapping: each relationship is written as its own Description
+// rather than the spec's blank-node form, and fields outside the ones below
+// (annotations, snippets, extracted licenses, ...) are not emitted.
+type rdfDescription struct {
+	XMLName xml.Name `xml:"rdf:Description"`
+	About   string   `xml:"rdf:about,attr"`
+	Type    rdfType  `xml:"rdf:type"`
+
+	Name         string `xml:"spdx:name,omitempty"`
+	VersionInfo  string `xml:"spdx:versionInfo,omitempty"`
+	Copyright    string `xml:"spdx:copyrightText,omitempty"`
+	LicenseDecl  string `xml:"spdx:licenseDeclared,omitempty"`
+	LicenseConcl string `xml:"spdx:licenseConcluded,omitempty"`
+	DownloadLoc  string `xml:"spdx:downloadLocation,omitempty"`
+	FileName     string `xml:"spdx:fileName,omitempty"`
+
+	RelationshipType string   `xml:"spdx:relationshipType,omitempty"`
+	RelatedElement   *rdfType `xml:"spdx:relatedSpdxElement,omitempty"`
+}
+
+type rdfType struct {
+	Resource string `xml:"rdf:resource,attr"`
+}
+
+type rdfDoc struct {
+	XMLName      xml.Name         `xml:"rdf:RDF"`
+	XmlnsRDF     string           `xml:"xmlns:rdf,attr"`
+	XmlnsSPDX    string           `xml:"xmlns:spdx,attr"`
+	Descriptions []rdfDescription `xml:"rdf:Description"`
+}
+
+func writeRDF(doc *spdx.Document) ([]byte, error) {
+	out := rdfDoc{
+		XmlnsRDF:  "http://www.w3.org/1999/02/22-rdf-syntax-ns#",
+		XmlnsSPDX: "http://spdx.org/rdf/terms#",
+	}
+
+	out.Descriptions = append(out.Descriptions, rdfDescription{
+		About: fmt.Sprintf("#%s", doc.SPDXIdentifier),
+		Type:  rdfType{Resource: "spdx:SpdxDocument"},
+		Name:  doc.DocumentName,
+	})
+
+	for _, pkg := range doc.Packages {
+		out.Descriptions = append(out.Descriptions, rdfDescription{
+			About:        fmt.Sprintf("#%s", pkg.PackageSPDXIdentifier),
+			Type:         rdfType{Resource: "spdx:Package"},
+			Name:         pkg.PackageName,
+			VersionInfo:  pkg.PackageVersion,
+			Copyright:    pkg.PackageCopyrightText,
+			LicenseDecl:  pkg.PackageLicenseDeclared,
+			LicenseConcl: pkg.PackageLicenseConcluded,
+			DownloadLoc:  pkg.PackageDownloadLocation,
+		})
+	}
+
+	for _, f := range doc.Files {
+		out.Descriptions = append(out.Descriptions, rdfDescription{
+			About:        fmt.Sprintf("#%s", f.FileSPDXIdentifier),
+			Type:         rdfType{Resource: "spdx:File"},
+			FileName:     f.FileName,
+			Copyright:    f.FileCopyrightText,
+			LicenseConcl: f.LicenseConcluded,
+		})
+	}
+
+	for _, rel := range doc.Relationships {
+		if rel == nil {
+			continue
+		}
+		out.Descriptions = append(out.Descriptions, rdfDescription{
+			About:            fmt.Sprintf("#%s", rel.RefA.ElementRefID),
+			Type:             rdfType{Resource: "spdx:Relationship"},
+			RelationshipType: string(rel.Relationship),
+			RelatedElement:   &rdfType{Resource: fmt.Sprintf("#%s", rel.RefB.ElementRefID)},
+		})
+	}
+
+	var buf bytes.Buffer
+	buf.WriteString(xml.Header)
+
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", " ")
+	if err := enc.Encode(out); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
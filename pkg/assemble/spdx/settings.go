@@ -0,0 +1,79 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import "context"
+
+// MergeSettings configures a single merge run: which SBOMs to read, the
+// primary component to describe them with, and where/how to write the
+// result.
+type MergeSettings struct {
+	Ctx *context.Context
+
+	Input  Input
+	Output Output
+	App    App
+}
+
+// Input lists the SBOMs being merged.
+type Input struct {
+	Files []string
+}
+
+// Output controls where the merged SBOM is written and in which
+// serialization. Format is resolved against File's extension when empty
+// (see ResolveFormat).
+type Output struct {
+	File   string
+	Format string
+}
+
+// App describes the primary component the merge should synthesize to
+// describe the merged inputs.
+type App struct {
+	Name           string
+	Version        string
+	Description    string
+	PrimaryPurpose string
+	Purl           string
+	CPE            string
+	Copyright      string
+
+	Supplier Contact
+	Authors  []Contact
+
+	License License
+
+	Checksums []Checksum
+}
+
+// Contact is a named, emailable party (a supplier or author).
+type Contact struct {
+	Name  string
+	Email string
+}
+
+// License is the declared/concluded license carried by the primary
+// component.
+type License struct {
+	Id         string
+	Expression string
+}
+
+// Checksum is one algorithm/value pair for the primary component.
+type Checksum struct {
+	Algorithm string
+	Value     string
+}
@@ -0,0 +1,54 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package spdx
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/tvsaver"
+	"sigs.k8s.io/yaml"
+)
+
+// Marshal renders doc in the requested SPDX serialization. JSON is kept as
+// the default so callers that never set a format see no behavior change.
+func Marshal(doc *spdx.Document, format Format) ([]byte, error) {
+	switch format {
+	case FormatTV:
+		var buf bytes.Buffer
+		if err := tvsaver.Save2_3(doc, &buf); err != nil {
+			return nil, fmt.Errorf("writing tag-value sbom: %w", err)
+		}
+		return buf.Bytes(), nil
+	case FormatYAML:
+		buf, err := yaml.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("writing yaml sbom: %w", err)
+		}
+		return buf, nil
+	case FormatRDF:
+		buf, err := writeRDF(doc)
+		if err != nil {
+			return nil, fmt.Errorf("writing rdf sbom: %w", err)
+		}
+		return buf, nil
+	case FormatJSON, "":
+		return json.MarshalIndent(doc, "", " ")
+	default:
+		return nil, fmt.Errorf("unsupported spdx output format: %s", format)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package diff
+
+import cdx "github.com/CycloneDX/cyclonedx-go"
+
+// CdxComponentReport is the license diff for a single CycloneDX component.
+type CdxComponentReport struct {
+	Licenses LicensePair
+}
+
+// CdxComponents diffs the license expression of two CycloneDX components.
+// Either side may be nil.
+func CdxComponents(a, b *cdx.Component) CdxComponentReport {
+	return CdxComponentReport{Licenses: classify(cdxComponentLicense(a), cdxComponentLicense(b))}
+}
+
+func cdxComponentLicense(c *cdx.Component) string {
+	if c == nil || c.Licenses == nil {
+		return ""
+	}
+	var parts []string
+	for _, choice := range *c.Licenses {
+		if choice.License != nil {
+			if choice.License.ID != "" {
+				parts = append(parts, choice.License.ID)
+			} else if choice.License.Name != "" {
+				parts = append(parts, choice.License.Name)
+			}
+		}
+		if choice.Expression != "" {
+			parts = append(parts, choice.Expression)
+		}
+	}
+	return joinUnique(parts)
+}
+
+func joinUnique(parts []string) string {
+	seen := make(map[string]struct{}, len(parts))
+	out := ""
+	for _, p := range parts {
+		if _, ok := seen[p]; ok {
+			continue
+		}
+		seen[p] = struct{}{}
+		if out != "" {
+			out += " AND "
+		}
+		out += p
+	}
+	return out
+}
+
+// CdxComponentKey returns the preferred identity for diffing a component:
+// its PURL when present, falling back to its bom-ref.
+func CdxComponentKey(c *cdx.Component) string {
+	if c.PackageURL != "" {
+		return c.PackageURL
+	}
+	return c.BOMRef
+}
+
+// CdxBomReport is the full license diff between two CycloneDX BOMs, keyed
+// by component PURL/bom-ref.
+type CdxBomReport struct {
+	Components map[string]CdxComponentReport
+}
+
+// CdxBoms diffs two CycloneDX BOMs component by component.
+func CdxBoms(a, b *cdx.BOM) CdxBomReport {
+	report := CdxBomReport{Components: make(map[string]CdxComponentReport)}
+
+	byKey := make(map[string]*cdx.Component)
+	if a.Components != nil {
+		for i := range *a.Components {
+			c := &(*a.Components)[i]
+			byKey[CdxComponentKey(c)] = c
+		}
+	}
+
+	bByKey := make(map[string]*cdx.Component)
+	if b.Components != nil {
+		for i := range *b.Components {
+			c := &(*b.Components)[i]
+			bByKey[CdxComponentKey(c)] = c
+		}
+	}
+
+	for key, ac := range byKey {
+		report.Components[key] = CdxComponents(ac, bByKey[key])
+	}
+	for key, bc := range bByKey {
+		if _, ok := report.Components[key]; ok {
+			continue
+		}
+		report.Components[key] = CdxComponents(byKey[key], bc)
+	}
+
+	return report
+}
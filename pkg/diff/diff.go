@@ -0,0 +1,170 @@
+// Copyright 2023 Interlynk.io
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package diff computes per-field license differences between two SBOM
+// snapshots, so editors and mergers can report exactly what they rewrote
+// versus what they preserved.
+package diff
+
+import "github.com/spdx/tools-golang/spdx"
+
+// Kind classifies how a LicensePair changed between two documents.
+type Kind string
+
+const (
+	Unchanged Kind = "unchanged"
+	Changed   Kind = "changed"
+	Added     Kind = "added"
+	Removed   Kind = "removed"
+)
+
+// LicensePair holds the license value observed for the same keyed entity
+// (a file name, a PURL, a package identifier, ...) in two documents.
+type LicensePair struct {
+	First  string
+	Second string
+	Kind   Kind
+}
+
+func classify(first, second string) LicensePair {
+	pair := LicensePair{First: first, Second: second}
+
+	switch {
+	case first == "" && second != "":
+		pair.Kind = Added
+	case first != "" && second == "":
+		pair.Kind = Removed
+	case first == second:
+		pair.Kind = Unchanged
+	default:
+		pair.Kind = Changed
+	}
+
+	return pair
+}
+
+// MakePairs walks firstKeys/firstVals seeding pairs with First, then folds
+// in secondKeys/secondVals, either filling Second on an existing pair or
+// creating a new one with an empty First. Every resulting pair is classified.
+func MakePairs(firstKeys, firstVals, secondKeys, secondVals []string) map[string]LicensePair {
+	firsts := make(map[string]string, len(firstKeys))
+	for i, key := range firstKeys {
+		firsts[key] = firstVals[i]
+	}
+
+	seconds := make(map[string]string, len(secondKeys))
+	for i, key := range secondKeys {
+		seconds[key] = secondVals[i]
+	}
+
+	pairs := make(map[string]LicensePair, len(firsts)+len(seconds))
+	for key, first := range firsts {
+		pairs[key] = classify(first, seconds[key])
+	}
+	for key, second := range seconds {
+		if _, ok := firsts[key]; ok {
+			continue
+		}
+		pairs[key] = classify("", second)
+	}
+
+	return pairs
+}
+
+// PackageReport is the license diff for a single package: its concluded
+// and declared license, each classified by how they changed between the
+// two documents. The per-file breakdown lives on DocumentReport, keyed by
+// file name, since files aren't scoped to a single package here.
+type PackageReport struct {
+	Concluded LicensePair
+	Declared  LicensePair
+}
+
+// Packages diffs the license-bearing fields of two SPDX packages. Either
+// side may be nil, in which case its fields are treated as empty.
+func Packages(a, b *spdx.Package) PackageReport {
+	return PackageReport{
+		Concluded: classify(packageLicenseConcluded(a), packageLicenseConcluded(b)),
+		Declared:  classify(packageLicenseDeclared(a), packageLicenseDeclared(b)),
+	}
+}
+
+func packageLicenseConcluded(p *spdx.Package) string {
+	if p == nil {
+		return ""
+	}
+	return p.PackageLicenseConcluded
+}
+
+func packageLicenseDeclared(p *spdx.Package) string {
+	if p == nil {
+		return ""
+	}
+	return p.PackageLicenseDeclared
+}
+
+// DocumentReport is the full license diff between two SPDX documents: the
+// document-level DataLicense, a per-package report keyed by package name,
+// and a per-file LicenseConcluded report keyed by file name.
+type DocumentReport struct {
+	DataLicense LicensePair
+	Packages    map[string]PackageReport
+	Files       map[string]LicensePair
+}
+
+// Documents diffs two SPDX documents field by field.
+func Documents(a, b *spdx.Document) DocumentReport {
+	report := DocumentReport{
+		DataLicense: classify(a.DataLicense, b.DataLicense),
+		Packages:    make(map[string]PackageReport),
+		Files:       MakePairs(fileNames(a), fileLicenses(a), fileNames(b), fileLicenses(b)),
+	}
+
+	for _, pkg := range a.Packages {
+		report.Packages[pkg.PackageName] = Packages(pkg, findPackageByName(b, pkg.PackageName))
+	}
+	for _, pkg := range b.Packages {
+		if _, ok := report.Packages[pkg.PackageName]; ok {
+			continue
+		}
+		report.Packages[pkg.PackageName] = Packages(findPackageByName(a, pkg.PackageName), pkg)
+	}
+
+	return report
+}
+
+func findPackageByName(doc *spdx.Document, name string) *spdx.Package {
+	for _, pkg := range doc.Packages {
+		if pkg.PackageName == name {
+			return pkg
+		}
+	}
+	return nil
+}
+
+func fileNames(doc *spdx.Document) []string {
+	names := make([]string, 0, len(doc.Files))
+	for _, f := range doc.Files {
+		names = append(names, f.FileName)
+	}
+	return names
+}
+
+func fileLicenses(doc *spdx.Document) []string {
+	lics := make([]string, 0, len(doc.Files))
+	for _, f := range doc.Files {
+		lics = append(lics, f.LicenseConcluded)
+	}
+	return lics
+}
@@ -0,0 +1,97 @@
+package edit
+
+import "context"
+
+// configParams is the resolved --edit config for a single run: what
+// subject to target, which fields to set, and whether to fill gaps
+// (missing), add alongside what's there (append), or overwrite.
+type configParams struct {
+	ctx *context.Context
+
+	search struct {
+		subject string // "document" | "primary-component" | "component-name-version"
+	}
+
+	semantics string // "missing" | "append" | "" (overwrite)
+
+	name    string
+	version string
+
+	supplier struct {
+		name  string
+		value string
+	}
+
+	authors []struct {
+		name  string
+		value string
+	}
+
+	purl string
+	cpe  string
+
+	license struct {
+		id         string
+		expression string
+	}
+
+	hashesList []struct {
+		algorithm string
+		value     string
+	}
+
+	copyright  string
+	copyrights []copyrightEntry
+
+	lifecycles []string
+
+	description string
+	repository  string
+	typ         string
+
+	// diff gates whether update() logs a before/after license diff for
+	// the edited package (see reportDiff in spdx_diff.go).
+	diff bool
+
+	annotation annotationConfig
+
+	// filesAnalyzedSet distinguishes "--files-analyzed was passed" from
+	// the bool's own zero value, since false is itself a meaningful,
+	// explicitly-requested setting.
+	filesAnalyzed    bool
+	filesAnalyzedSet bool
+
+	attributionTexts []string
+	packageComment   string
+	sourceInfo       string
+	homePage         string
+
+	// outputFormat drives the edit command's round-trip write, mirroring
+	// the merge command's Output.Format knob (json/tv/yaml/rdf).
+	outputFormat string
+}
+
+func (c *configParams) onMissing() bool { return c.semantics == "missing" }
+func (c *configParams) onAppend() bool  { return c.semantics == "append" }
+
+func (c *configParams) shouldName() bool        { return c.name != "" }
+func (c *configParams) shouldVersion() bool     { return c.version != "" }
+func (c *configParams) shouldSupplier() bool    { return c.supplier.name != "" || c.supplier.value != "" }
+func (c *configParams) shouldAuthors() bool     { return len(c.authors) > 0 }
+func (c *configParams) shouldPurl() bool        { return c.purl != "" }
+func (c *configParams) shouldCpe() bool         { return c.cpe != "" }
+func (c *configParams) shouldLicenses() bool    { return c.license.id != "" || c.license.expression != "" }
+func (c *configParams) shouldHashes() bool      { return len(c.hashesList) > 0 }
+func (c *configParams) shouldCopyRight() bool   { return c.copyright != "" || len(c.copyrights) > 0 }
+func (c *configParams) shouldLifeCycle() bool   { return len(c.lifecycles) > 0 }
+func (c *configParams) shouldDescription() bool { return c.description != "" }
+func (c *configParams) shouldRepository() bool  { return c.repository != "" }
+func (c *configParams) shouldTyp() bool         { return c.typ != "" }
+func (c *configParams) shouldDiff() bool        { return c.diff }
+func (c *configParams) shouldAnnotations() bool { return c.annotation.Type != "" }
+
+func (c *configParams) shouldFilesAnalyzed() bool    { return c.filesAnalyzedSet }
+func (c *configParams) shouldAttributionTexts() bool { return len(c.attributionTexts) > 0 }
+func (c *configParams) shouldPackageComment() bool   { return c.packageComment != "" }
+func (c *configParams) shouldSourceInfo() bool       { return c.sourceInfo != "" }
+func (c *configParams) shouldHomePage() bool         { return c.homePage != "" }
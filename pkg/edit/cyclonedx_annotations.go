@@ -0,0 +1,42 @@
+package edit
+
+import (
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+)
+
+// cdxApplyAnnotation is the CycloneDX-side counterpart to
+// spdxEditDoc.annotations: CycloneDX has no annotation concept, so the
+// type/annotator/date/comment are stashed as metadata.properties (document
+// subject) or component.properties (component subject) under the
+// sbomasm:annotation:* namespace.
+func cdxApplyAnnotation(props *[]cdx.Property, cfg annotationConfig) {
+	annotatorStr := cfg.Annotator
+	if annotatorStr == "" {
+		annotatorStr = defaultAnnotator()
+	}
+
+	date := cfg.Date
+	if date == "" {
+		date = utcNowTime()
+	}
+
+	kept := []cdx.Property{}
+	if props != nil {
+		for _, p := range *props {
+			if !strings.HasPrefix(p.Name, "sbomasm:annotation:") {
+				kept = append(kept, p)
+			}
+		}
+	}
+
+	kept = append(kept,
+		cdx.Property{Name: "sbomasm:annotation:type", Value: cfg.Type},
+		cdx.Property{Name: "sbomasm:annotation:annotator", Value: annotatorStr},
+		cdx.Property{Name: "sbomasm:annotation:date", Value: date},
+		cdx.Property{Name: "sbomasm:annotation:comment", Value: cfg.Comment},
+	)
+
+	*props = kept
+}
@@ -0,0 +1,43 @@
+package edit
+
+import (
+	"fmt"
+	"strings"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+)
+
+// cdxApplyCopyright is the CycloneDX-side counterpart to the SPDX
+// copyright annotations in spdx_copyright.go: it renders entries into component.copyright
+// using the same canonical block, and stashes the structured form under
+// component.properties (sbomasm:copyright:<n>:<field>) so it survives a
+// round-trip through the joined statement.
+func cdxApplyCopyright(comp *cdx.Component, entries []copyrightEntry) {
+	comp.Copyright = renderCopyrightText(entries)
+
+	props := []cdx.Property{}
+	if comp.Properties != nil {
+		for _, p := range *comp.Properties {
+			if !strings.HasPrefix(p.Name, "sbomasm:copyright:") {
+				props = append(props, p)
+			}
+		}
+	}
+
+	for i, e := range entries {
+		if e.Holder != "" {
+			props = append(props, cdx.Property{Name: fmt.Sprintf("sbomasm:copyright:%d:holder", i), Value: e.Holder})
+		}
+		if e.Years != "" {
+			props = append(props, cdx.Property{Name: fmt.Sprintf("sbomasm:copyright:%d:years", i), Value: e.Years})
+		}
+		if e.Statement != "" {
+			props = append(props, cdx.Property{Name: fmt.Sprintf("sbomasm:copyright:%d:statement", i), Value: e.Statement})
+		}
+		if e.Source != "" {
+			props = append(props, cdx.Property{Name: fmt.Sprintf("sbomasm:copyright:%d:source", i), Value: e.Source})
+		}
+	}
+
+	comp.Properties = &props
+}
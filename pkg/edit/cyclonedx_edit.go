@@ -0,0 +1,117 @@
+package edit
+
+import (
+	"fmt"
+
+	cdx "github.com/CycloneDX/cyclonedx-go"
+	"github.com/interlynk-io/sbomasm/pkg/logger"
+)
+
+// cdxEditDoc is the CycloneDX counterpart to spdxEditDoc: the same
+// configParams drive edits against a CycloneDX BOM and its resolved
+// subject component instead of an SPDX document and package.
+type cdxEditDoc struct {
+	bom  *cdx.BOM
+	comp *cdx.Component
+	c    *configParams
+}
+
+func NewCdxEditDoc(bom *cdx.BOM, c *configParams) *cdxEditDoc {
+	doc := &cdxEditDoc{}
+
+	doc.bom = bom
+	doc.c = c
+
+	if c.search.subject != "document" {
+		comp, err := cdxFindComponent(bom, c)
+		if err == nil {
+			doc.comp = comp
+		}
+	}
+	return doc
+}
+
+func (d *cdxEditDoc) update() {
+	log := logger.FromContext(*d.c.ctx)
+	log.Debug("CycloneDX updating sbom")
+
+	updateFuncs := []struct {
+		name string
+		f    func() error
+	}{
+		{"copyright", d.copyright},
+		{"annotation", d.annotation},
+	}
+
+	for _, item := range updateFuncs {
+		if err := item.f(); err != nil {
+			if err == errNotSupported {
+				log.Infof(fmt.Sprintf("CycloneDX error updating %s: %s", item.name, err))
+			}
+		}
+	}
+}
+
+// copyright is the CycloneDX counterpart to spdxEditDoc.copyright:
+// structured entries take precedence over the plain copyright string and
+// always replace component.copyright and the sbomasm:copyright:*
+// properties via cdxApplyCopyright; onMissing and onAppend are not applied
+// to them. The plain string honors onMissing and otherwise overwrites;
+// onAppend does not join it to an existing value.
+func (d *cdxEditDoc) copyright() error {
+	if !d.c.shouldCopyRight() {
+		return errNoConfiguration
+	}
+
+	if d.comp == nil {
+		return errNotSupported
+	}
+
+	if len(d.c.copyrights) > 0 {
+		cdxApplyCopyright(d.comp, d.c.copyrights)
+		return nil
+	}
+
+	if d.c.onMissing() {
+		if d.comp.Copyright == "" {
+			d.comp.Copyright = d.c.copyright
+		}
+	} else {
+		d.comp.Copyright = d.c.copyright
+	}
+
+	return nil
+}
+
+// annotation stashes the configured annotation under the resolved
+// subject's properties via cdxApplyAnnotation: metadata.properties for the
+// document subject, component.properties otherwise. CycloneDX has no
+// onMissing concept for this (cdxApplyAnnotation always replaces the
+// sbomasm:annotation:* keys), matching how a single annotation slot works
+// on the SPDX side only when overwriting.
+func (d *cdxEditDoc) annotation() error {
+	if !d.c.shouldAnnotations() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		if d.bom.Metadata == nil {
+			d.bom.Metadata = &cdx.Metadata{}
+		}
+		if d.bom.Metadata.Properties == nil {
+			d.bom.Metadata.Properties = &[]cdx.Property{}
+		}
+		cdxApplyAnnotation(d.bom.Metadata.Properties, d.c.annotation)
+		return nil
+	}
+
+	if d.comp == nil {
+		return errNotSupported
+	}
+
+	if d.comp.Properties == nil {
+		d.comp.Properties = &[]cdx.Property{}
+	}
+	cdxApplyAnnotation(d.comp.Properties, d.c.annotation)
+	return nil
+}
@@ -0,0 +1,145 @@
+package edit
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// copyrightEntry is one structured copyright statement supplied via config,
+// e.g. {holder: "Interlynk.io", years: "2021-2024"}.
+type copyrightEntry struct {
+	Holder    string
+	Years     string
+	Statement string
+	Source    string
+}
+
+var yearRangeRe = regexp.MustCompile(`(\d{4})(?:-(\d{4}))?`)
+
+type yearSpan struct {
+	min, max int
+}
+
+// renderCopyrightText folds entries into the canonical multi-line
+// PackageCopyrightText block sbomasm writes: one "Copyright <years>
+// <holder>" line per holder, sorted by holder, with overlapping year
+// ranges for the same holder unioned into a single span.
+func renderCopyrightText(entries []copyrightEntry) string {
+	spans := map[string]yearSpan{}
+	statements := map[string]struct{}{}
+	order := []string{}
+
+	for _, e := range entries {
+		if e.Statement != "" {
+			if _, ok := statements[e.Statement]; !ok {
+				statements[e.Statement] = struct{}{}
+				order = append(order, e.Statement)
+			}
+			continue
+		}
+
+		holder := strings.TrimSpace(e.Holder)
+		if holder == "" {
+			continue
+		}
+
+		min, max := parseYearRange(e.Years)
+		if s, ok := spans[holder]; ok {
+			if min != 0 && (s.min == 0 || min < s.min) {
+				s.min = min
+			}
+			if max > s.max {
+				s.max = max
+			}
+			spans[holder] = s
+		} else {
+			spans[holder] = yearSpan{min: min, max: max}
+			order = append(order, holder)
+		}
+	}
+
+	sort.Strings(order)
+
+	lines := make([]string, 0, len(order))
+	for _, key := range order {
+		if s, ok := spans[key]; ok {
+			if years := formatYearRange(s); years != "" {
+				lines = append(lines, fmt.Sprintf("Copyright %s %s", years, key))
+			} else {
+				lines = append(lines, fmt.Sprintf("Copyright %s", key))
+			}
+		} else {
+			lines = append(lines, key)
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func parseYearRange(years string) (int, int) {
+	m := yearRangeRe.FindStringSubmatch(years)
+	if m == nil {
+		return 0, 0
+	}
+	min, _ := strconv.Atoi(m[1])
+	max := min
+	if m[2] != "" {
+		max, _ = strconv.Atoi(m[2])
+	}
+	return min, max
+}
+
+func formatYearRange(s yearSpan) string {
+	if s.min == 0 {
+		return ""
+	}
+	if s.max == 0 || s.max == s.min {
+		return strconv.Itoa(s.min)
+	}
+	return fmt.Sprintf("%d-%d", s.min, s.max)
+}
+
+const copyrightAnnotationPrefix = "sbomasm:copyright"
+
+// copyrightAnnotations renders entries as OTHER annotations against
+// subjectID so the structured form (holder/years/source) survives a
+// round-trip through PackageCopyrightText, which only keeps the rendered
+// text.
+func copyrightAnnotations(entries []copyrightEntry, subjectID spdx.ElementID, annotator spdx.Annotator, date string) []spdx.Annotation {
+	anns := make([]spdx.Annotation, 0, len(entries))
+	for i, e := range entries {
+		var comment string
+		if e.Statement != "" {
+			comment = fmt.Sprintf("%s:%d statement=%q source=%q", copyrightAnnotationPrefix, i, e.Statement, e.Source)
+		} else {
+			comment = fmt.Sprintf("%s:%d holder=%q years=%q source=%q", copyrightAnnotationPrefix, i, e.Holder, e.Years, e.Source)
+		}
+
+		anns = append(anns, spdx.Annotation{
+			Annotator:                annotator,
+			AnnotationDate:           date,
+			AnnotationType:           "OTHER",
+			AnnotationSPDXIdentifier: spdx.DocElementID{ElementRefID: subjectID},
+			AnnotationComment:        comment,
+		})
+	}
+	return anns
+}
+
+// withoutCopyrightAnnotations drops prior sbomasm:copyright annotations for
+// subjectID, used when overwriting rather than appending.
+func withoutCopyrightAnnotations(anns []spdx.Annotation, subjectID spdx.ElementID) []spdx.Annotation {
+	kept := make([]spdx.Annotation, 0, len(anns))
+	for _, a := range anns {
+		if a.AnnotationSPDXIdentifier.ElementRefID == subjectID && strings.HasPrefix(a.AnnotationComment, copyrightAnnotationPrefix) {
+			continue
+		}
+		kept = append(kept, a)
+	}
+	return kept
+}
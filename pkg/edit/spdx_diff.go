@@ -0,0 +1,30 @@
+package edit
+
+import (
+	"github.com/interlynk-io/sbomasm/pkg/diff"
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// snapshotPackage clones just the license-bearing fields of pkg so a
+// before/after diff survives the in-place mutations update() performs.
+func snapshotPackage(pkg *spdx.Package) *spdx.Package {
+	if pkg == nil {
+		return nil
+	}
+	return &spdx.Package{
+		PackageName:             pkg.PackageName,
+		PackageLicenseConcluded: pkg.PackageLicenseConcluded,
+		PackageLicenseDeclared:  pkg.PackageLicenseDeclared,
+	}
+}
+
+// reportDiff computes the license diff between the package snapshot taken
+// before update() ran and the package's current state, returning nil when
+// the edit has no package subject (e.g. subject is "document").
+func (d *spdxEditDoc) reportDiff(before *spdx.Package) *diff.PackageReport {
+	if d.pkg == nil {
+		return nil
+	}
+	report := diff.Packages(before, d.pkg)
+	return &report
+}
@@ -2,6 +2,8 @@ package edit
 
 import (
 	"fmt"
+	"io"
+	"regexp"
 	"strings"
 
 	"github.com/interlynk-io/sbomasm/pkg/logger"
@@ -46,6 +48,8 @@ func (d *spdxEditDoc) update() {
 	log := logger.FromContext(*d.c.ctx)
 	log.Debug("SPDX updating sbom")
 
+	before := snapshotPackage(d.pkg)
+
 	updateFuncs := []struct {
 		name string
 		f    func() error
@@ -65,6 +69,12 @@ func (d *spdxEditDoc) update() {
 		{"repository", d.repository},
 		{"type", d.typ},
 		{"timeStamp", d.timeStamp},
+		{"annotations", d.annotations},
+		{"filesAnalyzed", d.filesAnalyzed},
+		{"attributionTexts", d.attributionTexts},
+		{"packageComment", d.packageComment},
+		{"sourceInfo", d.sourceInfo},
+		{"homepage", d.homepage},
 	}
 
 	for _, item := range updateFuncs {
@@ -74,6 +84,19 @@ func (d *spdxEditDoc) update() {
 			}
 		}
 	}
+
+	if d.c.shouldDiff() {
+		if report := d.reportDiff(before); report != nil {
+			log.Infof("SPDX license diff: concluded=%s declared=%s",
+				report.Concluded.Kind, report.Declared.Kind)
+		}
+	}
+}
+
+// Write serializes the edited document to w, using the same format knob
+// (json/tv/yaml/rdf) the merge command honors.
+func (d *spdxEditDoc) Write(w io.Writer, outPath string) error {
+	return WriteSpdxDoc(d.bom, d.c.outputFormat, outPath, w)
 }
 
 func (d *spdxEditDoc) name() error {
@@ -459,6 +482,35 @@ func (d *spdxEditDoc) copyright() error {
 		return errNotSupported
 	}
 
+	if len(d.c.copyrights) > 0 {
+		text := renderCopyrightText(d.c.copyrights)
+
+		if d.c.onMissing() {
+			if d.pkg.PackageCopyrightText == "" {
+				d.pkg.PackageCopyrightText = text
+			}
+		} else if d.c.onAppend() {
+			if d.pkg.PackageCopyrightText == "" || d.pkg.PackageCopyrightText == "NOASSERTION" {
+				d.pkg.PackageCopyrightText = text
+			} else {
+				d.pkg.PackageCopyrightText = strings.Join([]string{d.pkg.PackageCopyrightText, text}, "\n")
+			}
+		} else {
+			d.pkg.PackageCopyrightText = text
+		}
+
+		annotator := spdx.Annotator{AnnotatorType: "Tool", Annotator: fmt.Sprintf("%s-%s", SBOMASM, SBOMASM_VERSION)}
+		newAnns := copyrightAnnotations(d.c.copyrights, d.pkg.PackageSPDXIdentifier, annotator, utcNowTime())
+
+		if d.c.onAppend() {
+			d.bom.Annotations = append(d.bom.Annotations, newAnns...)
+		} else {
+			d.bom.Annotations = append(withoutCopyrightAnnotations(d.bom.Annotations, d.pkg.PackageSPDXIdentifier), newAnns...)
+		}
+
+		return nil
+	}
+
 	if d.c.onMissing() {
 		if d.pkg.PackageCopyrightText == "" {
 			d.pkg.PackageCopyrightText = d.c.copyright
@@ -552,6 +604,123 @@ func (d *spdxEditDoc) timeStamp() error {
 	return nil
 }
 
+var packageVerificationCodeRe = regexp.MustCompile(`^[0-9a-fA-F]{40}$`)
+
+// filesAnalyzed toggles Package.FilesAnalyzed. Turning it off blanks
+// PackageVerificationCode, since the SPDX spec ties the two together;
+// turning it on requires a verification code already be present.
+func (d *spdxEditDoc) filesAnalyzed() error {
+	if !d.c.shouldFilesAnalyzed() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		return errNotSupported
+	}
+
+	if !d.c.filesAnalyzed {
+		d.pkg.FilesAnalyzed = false
+		d.pkg.PackageVerificationCode = spdx.PackageVerificationCode{}
+		return nil
+	}
+
+	if !packageVerificationCodeRe.MatchString(d.pkg.PackageVerificationCode.Value) {
+		return errInvalidInput
+	}
+
+	d.pkg.FilesAnalyzed = true
+	return nil
+}
+
+func (d *spdxEditDoc) attributionTexts() error {
+	if !d.c.shouldAttributionTexts() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		return errNotSupported
+	}
+
+	if d.c.onMissing() {
+		if len(d.pkg.PackageAttributionTexts) == 0 {
+			d.pkg.PackageAttributionTexts = d.c.attributionTexts
+		}
+	} else if d.c.onAppend() {
+		d.pkg.PackageAttributionTexts = append(d.pkg.PackageAttributionTexts, d.c.attributionTexts...)
+	} else {
+		d.pkg.PackageAttributionTexts = d.c.attributionTexts
+	}
+
+	return nil
+}
+
+func (d *spdxEditDoc) packageComment() error {
+	if !d.c.shouldPackageComment() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		if d.c.onMissing() {
+			if d.bom.DocumentComment == "" {
+				d.bom.DocumentComment = d.c.packageComment
+			}
+		} else {
+			d.bom.DocumentComment = d.c.packageComment
+		}
+		return nil
+	}
+
+	if d.c.onMissing() {
+		if d.pkg.PackageComment == "" {
+			d.pkg.PackageComment = d.c.packageComment
+		}
+	} else {
+		d.pkg.PackageComment = d.c.packageComment
+	}
+
+	return nil
+}
+
+func (d *spdxEditDoc) sourceInfo() error {
+	if !d.c.shouldSourceInfo() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		return errNotSupported
+	}
+
+	if d.c.onMissing() {
+		if d.pkg.PackageSourceInfo == "" {
+			d.pkg.PackageSourceInfo = d.c.sourceInfo
+		}
+	} else {
+		d.pkg.PackageSourceInfo = d.c.sourceInfo
+	}
+
+	return nil
+}
+
+func (d *spdxEditDoc) homepage() error {
+	if !d.c.shouldHomePage() {
+		return errNoConfiguration
+	}
+
+	if d.c.search.subject == "document" {
+		return errNotSupported
+	}
+
+	if d.c.onMissing() {
+		if d.pkg.PackageHomePage == "" {
+			d.pkg.PackageHomePage = d.c.homePage
+		}
+	} else {
+		d.pkg.PackageHomePage = d.c.homePage
+	}
+
+	return nil
+}
+
 func (d *spdxEditDoc) lifeCycles() error {
 	if !d.c.shouldLifeCycle() {
 		return errNoConfiguration
@@ -0,0 +1,177 @@
+package edit
+
+import (
+	"testing"
+
+	"github.com/spdx/tools-golang/spdx"
+	"github.com/spdx/tools-golang/spdx/v2/common"
+)
+
+func newTestSpdxEditDoc(c *configParams) *spdxEditDoc {
+	c.search.subject = "primary-component"
+
+	pkg := &spdx.Package{
+		PackageSPDXIdentifier: common.ElementID("Package-test"),
+		PackageName:           "test",
+	}
+
+	bom := &spdx.Document{
+		SPDXVersion:    "SPDX-2.3",
+		SPDXIdentifier: common.ElementID("DOCUMENT"),
+		Packages:       []*spdx.Package{pkg},
+	}
+
+	return &spdxEditDoc{bom: bom, pkg: pkg, c: c}
+}
+
+func TestFilesAnalyzed(t *testing.T) {
+	validCode := "da39a3ee5e6b4b0d3255bfef95601890afd80709"
+
+	tests := []struct {
+		name          string
+		filesAnalyzed bool
+		initialCode   string
+		wantErr       error
+		wantAnalyzed  bool
+	}{
+		{
+			name:          "turning on requires a valid verification code",
+			filesAnalyzed: true,
+			initialCode:   validCode,
+			wantAnalyzed:  true,
+		},
+		{
+			name:          "turning on without a verification code is invalid",
+			filesAnalyzed: true,
+			initialCode:   "",
+			wantErr:       errInvalidInput,
+		},
+		{
+			name:          "turning off clears the verification code",
+			filesAnalyzed: false,
+			initialCode:   validCode,
+			wantAnalyzed:  false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &configParams{filesAnalyzedSet: true, filesAnalyzed: tt.filesAnalyzed}
+			d := newTestSpdxEditDoc(c)
+			d.pkg.PackageVerificationCode = spdx.PackageVerificationCode{Value: tt.initialCode}
+
+			err := d.filesAnalyzed()
+			if tt.wantErr != nil {
+				if err != tt.wantErr {
+					t.Fatalf("filesAnalyzed() error = %v, want %v", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("filesAnalyzed() unexpected error: %v", err)
+			}
+			if d.pkg.FilesAnalyzed != tt.wantAnalyzed {
+				t.Errorf("FilesAnalyzed = %v, want %v", d.pkg.FilesAnalyzed, tt.wantAnalyzed)
+			}
+			if !tt.wantAnalyzed && d.pkg.PackageVerificationCode.Value != "" {
+				t.Errorf("PackageVerificationCode.Value = %q, want cleared", d.pkg.PackageVerificationCode.Value)
+			}
+		})
+	}
+}
+
+func TestAttributionTexts(t *testing.T) {
+	tests := []struct {
+		name      string
+		semantics string
+		existing  []string
+		configure []string
+		want      []string
+	}{
+		{
+			name:      "overwrite replaces existing",
+			semantics: "",
+			existing:  []string{"old"},
+			configure: []string{"new"},
+			want:      []string{"new"},
+		},
+		{
+			name:      "missing leaves existing alone",
+			semantics: "missing",
+			existing:  []string{"old"},
+			configure: []string{"new"},
+			want:      []string{"old"},
+		},
+		{
+			name:      "missing fills empty",
+			semantics: "missing",
+			existing:  nil,
+			configure: []string{"new"},
+			want:      []string{"new"},
+		},
+		{
+			name:      "append adds to existing",
+			semantics: "append",
+			existing:  []string{"old"},
+			configure: []string{"new"},
+			want:      []string{"old", "new"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &configParams{semantics: tt.semantics, attributionTexts: tt.configure}
+			d := newTestSpdxEditDoc(c)
+			d.pkg.PackageAttributionTexts = tt.existing
+
+			if err := d.attributionTexts(); err != nil {
+				t.Fatalf("attributionTexts() unexpected error: %v", err)
+			}
+
+			if len(d.pkg.PackageAttributionTexts) != len(tt.want) {
+				t.Fatalf("PackageAttributionTexts = %v, want %v", d.pkg.PackageAttributionTexts, tt.want)
+			}
+			for i, v := range tt.want {
+				if d.pkg.PackageAttributionTexts[i] != v {
+					t.Errorf("PackageAttributionTexts[%d] = %q, want %q", i, d.pkg.PackageAttributionTexts[i], v)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageComment(t *testing.T) {
+	c := &configParams{packageComment: "generated by sbomasm"}
+	d := newTestSpdxEditDoc(c)
+
+	if err := d.packageComment(); err != nil {
+		t.Fatalf("packageComment() unexpected error: %v", err)
+	}
+	if d.pkg.PackageComment != "generated by sbomasm" {
+		t.Errorf("PackageComment = %q, want %q", d.pkg.PackageComment, "generated by sbomasm")
+	}
+}
+
+func TestSourceInfo(t *testing.T) {
+	c := &configParams{sourceInfo: "built from source"}
+	d := newTestSpdxEditDoc(c)
+
+	if err := d.sourceInfo(); err != nil {
+		t.Fatalf("sourceInfo() unexpected error: %v", err)
+	}
+	if d.pkg.PackageSourceInfo != "built from source" {
+		t.Errorf("PackageSourceInfo = %q, want %q", d.pkg.PackageSourceInfo, "built from source")
+	}
+}
+
+func TestHomepage(t *testing.T) {
+	c := &configParams{homePage: "https://example.com"}
+	d := newTestSpdxEditDoc(c)
+
+	if err := d.homepage(); err != nil {
+		t.Fatalf("homepage() unexpected error: %v", err)
+	}
+	if d.pkg.PackageHomePage != "https://example.com" {
+		t.Errorf("PackageHomePage = %q, want %q", d.pkg.PackageHomePage, "https://example.com")
+	}
+}
@@ -0,0 +1,23 @@
+package edit
+
+import (
+	"io"
+
+	aspdx "github.com/interlynk-io/sbomasm/pkg/assemble/spdx"
+	"github.com/spdx/tools-golang/spdx"
+)
+
+// WriteSpdxDoc serializes bom to w using the same format knob
+// (json/tv/yaml/rdf) that the merge command honors, so `sbomasm edit
+// --output-format tv` round-trips through the same writers.
+func WriteSpdxDoc(bom *spdx.Document, outputFormat, outPath string, w io.Writer) error {
+	format := aspdx.ResolveFormat(outputFormat, outPath)
+
+	buf, err := aspdx.Marshal(bom, format)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(buf)
+	return err
+}